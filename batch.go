@@ -0,0 +1,151 @@
+package opik
+
+import (
+	"context"
+	"time"
+)
+
+// BatchConfig tunes the behavior of the batching exporter installed by
+// WithBatchExporter, modeled on the OTel BatchSpanProcessor's knobs.
+type BatchConfig struct {
+	// MaxQueueSize bounds the number of pending items buffered in memory
+	// before new submissions start blocking the caller. Defaults to 2048.
+	MaxQueueSize int
+
+	// MaxExportBatchSize caps how many items are sent in a single bulk
+	// request. Defaults to 512.
+	MaxExportBatchSize int
+
+	// ScheduledDelay is how long the background goroutine waits between
+	// flushes when the queue isn't already full. Defaults to 5s.
+	ScheduledDelay time.Duration
+
+	// ExportTimeout bounds how long one flush of a batch may take, covering
+	// all of its bulk requests, per-item feedback submissions and their
+	// retries; items not submitted by then are dropped. Defaults to 30s.
+	ExportTimeout time.Duration
+}
+
+func (c BatchConfig) withDefaults() BatchConfig {
+	if c.MaxQueueSize <= 0 {
+		c.MaxQueueSize = 2048
+	}
+	if c.MaxExportBatchSize <= 0 {
+		c.MaxExportBatchSize = 512
+	}
+	if c.ScheduledDelay <= 0 {
+		c.ScheduledDelay = 5 * time.Second
+	}
+	if c.ExportTimeout <= 0 {
+		c.ExportTimeout = 30 * time.Second
+	}
+	return c
+}
+
+// exporter is the internal seam between trace.End/span.End and however the
+// resulting payload is actually delivered: synchronously inline, or queued
+// for a background batch exporter to pick up.
+type exporter interface {
+	enqueueTrace(t *traceData)
+	enqueueSpan(s *spanData)
+	enqueueFeedback(traceID, spanID string, score FeedbackScore)
+	ForceFlush(ctx context.Context) error
+	Shutdown(ctx context.Context) error
+}
+
+// submitTrace routes a trace creation or update through the client's
+// exporter: synchronously when using the default sync exporter, surfacing
+// any HTTP error to the caller, or queued for the background batch exporter
+// otherwise (in which case submission errors are retried until the flush's
+// ExportTimeout and then dropped; they are not returned to any caller, not
+// even ForceFlush).
+// Used by both Client.Trace and Trace.End, so creating many traces/spans
+// under WithBatchExporter is batched too, not just the end-of-life update.
+func (c *Client) submitTrace(ctx context.Context, t *traceData) error {
+	if se, ok := c.exporter.(*syncExporter); ok {
+		return se.transport.SubmitTrace(ctx, t)
+	}
+	c.exporter.enqueueTrace(t)
+	return nil
+}
+
+// submitSpan is submitTrace's counterpart for spans.
+func (c *Client) submitSpan(ctx context.Context, s *spanData) error {
+	if se, ok := c.exporter.(*syncExporter); ok {
+		return se.transport.SubmitSpan(ctx, s)
+	}
+	c.exporter.enqueueSpan(s)
+	return nil
+}
+
+// submitFeedback is submitTrace's counterpart for feedback scores.
+func (c *Client) submitFeedback(ctx context.Context, traceID, spanID string, score FeedbackScore) error {
+	if se, ok := c.exporter.(*syncExporter); ok {
+		return se.transport.SubmitFeedback(ctx, traceID, spanID, score)
+	}
+	c.exporter.enqueueFeedback(traceID, spanID, score)
+	return nil
+}
+
+// WithSyncExporter makes trace/span/feedback submission synchronous again,
+// i.e. the call to trace.End/span.End/AddFeedbackScore does the HTTP (or
+// OTLP) round trip itself and returns only once it completes. This is the
+// behavior Client had before WithBatchExporter existed, and it remains the
+// default; tests that want deterministic "the data is there by the time End
+// returns" semantics should use it explicitly.
+func WithSyncExporter() ClientOption {
+	return func(c *Client) error {
+		c.batchConfig = nil
+		return nil
+	}
+}
+
+// WithBatchExporter enqueues traces, spans, and feedback scores onto a
+// bounded channel instead of submitting them inline, and drains that queue
+// from a background goroutine that groups items by endpoint and posts traces
+// and spans as bulk requests (feedback scores go one request each), retrying
+// any failed request, 5xx or otherwise, with exponential backoff.
+//
+// The actual batchExporter is constructed once NewClient has resolved a
+// transport (whether from WithTransport or the REST default), since it needs
+// one to flush to.
+func WithBatchExporter(cfg BatchConfig) ClientOption {
+	return func(c *Client) error {
+		resolved := cfg.withDefaults()
+		c.batchConfig = &resolved
+		return nil
+	}
+}
+
+// ForceFlush blocks until every currently-queued trace, span, and feedback
+// score has been submitted, or ctx is done.
+func (c *Client) ForceFlush(ctx context.Context) error {
+	return c.exporter.ForceFlush(ctx)
+}
+
+// Shutdown flushes any remaining queued items and stops the background
+// exporter goroutine. The Client must not be used after Shutdown returns.
+func (c *Client) Shutdown(ctx context.Context) error {
+	return c.exporter.Shutdown(ctx)
+}
+
+// syncExporter is the original, non-batching exporter: every call goes
+// straight to the transport and blocks on its result.
+type syncExporter struct {
+	transport Transport
+}
+
+func (e *syncExporter) enqueueTrace(t *traceData) {
+	_ = e.transport.SubmitTrace(context.Background(), t)
+}
+
+func (e *syncExporter) enqueueSpan(s *spanData) {
+	_ = e.transport.SubmitSpan(context.Background(), s)
+}
+
+func (e *syncExporter) enqueueFeedback(traceID, spanID string, score FeedbackScore) {
+	_ = e.transport.SubmitFeedback(context.Background(), traceID, spanID, score)
+}
+
+func (e *syncExporter) ForceFlush(ctx context.Context) error { return nil }
+func (e *syncExporter) Shutdown(ctx context.Context) error   { return nil }
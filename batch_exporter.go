@@ -0,0 +1,232 @@
+package opik
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// queuedItem is the union of the three kinds of payload the batch exporter
+// can carry, tagged by which field is set.
+type queuedItem struct {
+	trace    *traceData
+	span     *spanData
+	feedback *feedbackItem
+}
+
+type feedbackItem struct {
+	traceID string
+	spanID  string
+	score   FeedbackScore
+}
+
+// batchExporter groups traces, spans, and feedback scores queued by
+// trace.End/span.End/AddFeedbackScore and flushes them as bulk requests on a
+// fixed schedule, modeled on the OTel BatchSpanProcessor.
+type batchExporter struct {
+	transport Transport
+	cfg       BatchConfig
+
+	queue    chan queuedItem
+	flushReq chan chan struct{}
+	done     chan struct{}
+	wg       sync.WaitGroup
+}
+
+func newBatchExporter(transport Transport, cfg BatchConfig) *batchExporter {
+	e := &batchExporter{
+		transport: transport,
+		cfg:       cfg,
+		queue:     make(chan queuedItem, cfg.MaxQueueSize),
+		flushReq:  make(chan chan struct{}),
+		done:      make(chan struct{}),
+	}
+	e.wg.Add(1)
+	go e.run()
+	return e
+}
+
+func (e *batchExporter) enqueueTrace(t *traceData) {
+	e.queue <- queuedItem{trace: t}
+}
+
+func (e *batchExporter) enqueueSpan(s *spanData) {
+	e.queue <- queuedItem{span: s}
+}
+
+func (e *batchExporter) enqueueFeedback(traceID, spanID string, score FeedbackScore) {
+	e.queue <- queuedItem{feedback: &feedbackItem{traceID: traceID, spanID: spanID, score: score}}
+}
+
+func (e *batchExporter) run() {
+	defer e.wg.Done()
+
+	ticker := time.NewTicker(e.cfg.ScheduledDelay)
+	defer ticker.Stop()
+
+	var batch []queuedItem
+	flush := func() {
+		if len(batch) == 0 {
+			return
+		}
+		e.flushBatch(batch)
+		batch = batch[:0]
+	}
+	// drainQueue pulls in everything currently buffered in the channel
+	// without blocking, so a flush (scheduled or forced) covers items that
+	// arrived before it but haven't been read into batch yet.
+	drainQueue := func() {
+		for {
+			select {
+			case item := <-e.queue:
+				batch = append(batch, item)
+			default:
+				return
+			}
+		}
+	}
+
+	for {
+		select {
+		case item := <-e.queue:
+			batch = append(batch, item)
+			if len(batch) >= e.cfg.MaxExportBatchSize {
+				flush()
+			}
+		case <-ticker.C:
+			flush()
+		case ack := <-e.flushReq:
+			drainQueue()
+			flush()
+			close(ack)
+		case <-e.done:
+			drainQueue()
+			flush()
+			return
+		}
+	}
+}
+
+func (e *batchExporter) flushBatch(batch []queuedItem) {
+	ctx, cancel := context.WithTimeout(context.Background(), e.cfg.ExportTimeout)
+	defer cancel()
+
+	var traces []*traceData
+	var spans []*spanData
+	var feedbacks []*feedbackItem
+	for _, item := range batch {
+		switch {
+		case item.trace != nil:
+			traces = append(traces, item.trace)
+		case item.span != nil:
+			spans = append(spans, item.span)
+		case item.feedback != nil:
+			feedbacks = append(feedbacks, item.feedback)
+		}
+	}
+
+	withBackoff(ctx, func() error { return submitTracesBulk(ctx, e.transport, traces) })
+	withBackoff(ctx, func() error { return submitSpansBulk(ctx, e.transport, spans) })
+	for _, f := range feedbacks {
+		fb := f
+		withBackoff(ctx, func() error { return e.transport.SubmitFeedback(ctx, fb.traceID, fb.spanID, fb.score) })
+	}
+}
+
+// withBackoff retries fn with exponential backoff until it succeeds or ctx
+// is done. It's intentionally simple: the transport already groups items
+// into bulk requests, so retries here are a safety net against transient
+// 5xx responses rather than the primary reliability mechanism.
+func withBackoff(ctx context.Context, fn func() error) {
+	delay := 100 * time.Millisecond
+	const maxDelay = 5 * time.Second
+
+	for {
+		if err := fn(); err == nil {
+			return
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(delay):
+		}
+		delay *= 2
+		if delay > maxDelay {
+			delay = maxDelay
+		}
+	}
+}
+
+// submitTracesBulk and submitSpansBulk post grouped items to the bulk
+// endpoints (/v1/private/traces/batch, /v1/private/spans/batch) when the
+// transport is REST-backed, or fall back to one call per item otherwise
+// (e.g. OTLP/gRPC has no bulk endpoint of its own).
+func submitTracesBulk(ctx context.Context, t Transport, traces []*traceData) error {
+	if len(traces) == 0 {
+		return nil
+	}
+	if bulk, ok := t.(bulkTraceSubmitter); ok {
+		return bulk.SubmitTracesBulk(ctx, traces)
+	}
+	for _, tr := range traces {
+		if err := t.SubmitTrace(ctx, tr); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func submitSpansBulk(ctx context.Context, t Transport, spans []*spanData) error {
+	if len(spans) == 0 {
+		return nil
+	}
+	if bulk, ok := t.(bulkSpanSubmitter); ok {
+		return bulk.SubmitSpansBulk(ctx, spans)
+	}
+	for _, s := range spans {
+		if err := t.SubmitSpan(ctx, s); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// bulkTraceSubmitter and bulkSpanSubmitter are optionally implemented by a
+// Transport that supports posting many items in a single request; restTransport
+// implements both against the Opik batch endpoints.
+type bulkTraceSubmitter interface {
+	SubmitTracesBulk(ctx context.Context, traces []*traceData) error
+}
+
+type bulkSpanSubmitter interface {
+	SubmitSpansBulk(ctx context.Context, spans []*spanData) error
+}
+
+// ForceFlush asks the run goroutine to flush, rather than draining e.queue
+// itself: run is the only reader of e.queue, so a second concurrent
+// consumer here would race it, and items run had already pulled into its
+// local batch (but not yet flushed) would be invisible to this call,
+// letting ForceFlush return having flushed nothing.
+func (e *batchExporter) ForceFlush(ctx context.Context) error {
+	ack := make(chan struct{})
+	select {
+	case e.flushReq <- ack:
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-e.done:
+		return nil
+	}
+
+	select {
+	case <-ack:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (e *batchExporter) Shutdown(ctx context.Context) error {
+	close(e.done)
+	e.wg.Wait()
+	return e.transport.Close()
+}
@@ -0,0 +1,70 @@
+package opik
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestIntegration_BatchExporter(t *testing.T) {
+	client, _ := newTestClient(t, WithBatchExporter(BatchConfig{
+		MaxQueueSize:       20000,
+		MaxExportBatchSize: 500,
+		ScheduledDelay:     100 * time.Millisecond,
+		ExportTimeout:      10 * time.Second,
+	}))
+	defer client.Shutdown(context.Background())
+
+	ctx := context.Background()
+	tr, err := client.Trace(ctx, "batch-exporter-trace")
+	if err != nil {
+		t.Fatalf("Failed to create trace: %v", err)
+	}
+
+	const spanCount = 10000
+	for i := 0; i < spanCount; i++ {
+		span, err := tr.Span(ctx, fmt.Sprintf("batch-span-%d", i), WithSpanType(SpanTypeLLM))
+		if err != nil {
+			t.Fatalf("Failed to create span %d: %v", i, err)
+		}
+		if err := span.End(ctx); err != nil {
+			t.Fatalf("Failed to end span %d: %v", i, err)
+		}
+	}
+
+	if err := client.ForceFlush(ctx); err != nil {
+		t.Fatalf("Failed to flush: %v", err)
+	}
+
+	got, err := client.CountSpans(ctx, tr.ID())
+	if err != nil {
+		t.Fatalf("Failed to count spans: %v", err)
+	}
+	if got != spanCount {
+		t.Fatalf("expected all %d spans to arrive, got %d", spanCount, got)
+	}
+}
+
+func BenchmarkBatchExporter_EnqueueSpan(b *testing.B) {
+	exporter := newBatchExporter(&noopTransport{}, BatchConfig{}.withDefaults())
+	defer exporter.Shutdown(context.Background())
+
+	span := &spanData{id: newID(), traceID: newID(), name: "bench"}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		exporter.enqueueSpan(span)
+	}
+}
+
+// noopTransport discards everything; used to benchmark the exporter's
+// queueing and batching overhead in isolation from network I/O.
+type noopTransport struct{}
+
+func (noopTransport) SubmitTrace(ctx context.Context, t *traceData) error { return nil }
+func (noopTransport) SubmitSpan(ctx context.Context, s *spanData) error   { return nil }
+func (noopTransport) SubmitFeedback(ctx context.Context, traceID, spanID string, f FeedbackScore) error {
+	return nil
+}
+func (noopTransport) Close() error { return nil }
@@ -0,0 +1,108 @@
+package opik
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/agentplexus/go-comet-ml-opik/eval"
+)
+
+// ClientOption configures a Client at construction time, following the same
+// functional-options pattern used throughout this package.
+type ClientOption func(*Client) error
+
+// Client is the entry point for creating traces and spans against Opik. A
+// Client is safe for concurrent use.
+type Client struct {
+	config Config
+
+	// otel is non-nil once WithOTelBridge is supplied; every trace/span
+	// created afterwards also emits a mirrored OTel span through it.
+	otel *otelBridge
+
+	// transport delivers traces, spans, and feedback scores to the
+	// backend. Defaults to REST; overridden by WithTransport.
+	transport Transport
+
+	// pendingTransportKind is set by WithTransport(TransportOTLPGRPC) and
+	// resolved into transport once the options loop has finished, since
+	// WithOTLPEndpoint may be supplied either before or after WithTransport.
+	pendingTransportKind *TransportKind
+
+	// exporter decides whether End/AddFeedbackScore calls hit transport
+	// synchronously or get queued for a background batch flush. Defaults
+	// to synchronous; overridden by WithBatchExporter.
+	exporter exporter
+
+	// batchConfig is set by WithBatchExporter and consumed once the
+	// transport has been resolved, since the batch exporter needs one to
+	// flush to.
+	batchConfig *BatchConfig
+
+	// evaluators run automatically against every span as it ends; see
+	// WithEvaluators.
+	evaluators []eval.Evaluator
+}
+
+// NewClient builds a Client from environment defaults (OPIK_API_KEY,
+// OPIK_WORKSPACE, OPIK_URL_OVERRIDE) plus any options, which are applied in
+// order and may override those defaults.
+func NewClient(opts ...ClientOption) (*Client, error) {
+	c := &Client{
+		config: defaultConfig(),
+	}
+
+	for _, opt := range opts {
+		if err := opt(c); err != nil {
+			return nil, fmt.Errorf("opik: applying client option: %w", err)
+		}
+	}
+
+	if c.pendingTransportKind != nil {
+		t, err := newOTLPGRPCTransport(c.config)
+		if err != nil {
+			return nil, fmt.Errorf("opik: applying client option: %w", err)
+		}
+		c.transport = t
+	}
+	if c.transport == nil {
+		c.transport = newRESTTransport(c.config)
+	}
+	if c.batchConfig != nil {
+		c.exporter = newBatchExporter(c.transport, *c.batchConfig)
+	} else {
+		c.exporter = &syncExporter{transport: c.transport}
+	}
+
+	return c, nil
+}
+
+// Config returns the resolved configuration this Client was built with.
+func (c *Client) Config() Config {
+	return c.config
+}
+
+// WithProjectName sets the Opik project that traces and spans created by
+// this Client are attributed to.
+func WithProjectName(name string) ClientOption {
+	return func(c *Client) error {
+		c.config.ProjectName = name
+		return nil
+	}
+}
+
+// rootContext returns the base context new root traces should inherit from,
+// seeded from the TRACEPARENT environment variable when present so a trace
+// started by an already-instrumented parent process continues that trace
+// instead of starting a new one. A valid OTel span context already on ctx
+// takes precedence over TRACEPARENT.
+func (c *Client) rootContext(ctx context.Context) context.Context {
+	if otelAttributesFromContext(ctx) != nil {
+		return ctx
+	}
+	sc, ok := otelSpanContextFromEnv()
+	if !ok {
+		return ctx
+	}
+	return contextWithOTelSpanContext(ctx, sc)
+}
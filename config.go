@@ -0,0 +1,44 @@
+package opik
+
+import "os"
+
+// defaultURL is the public Opik Cloud API base URL used when neither
+// OPIK_URL_OVERRIDE nor a client option supplies one.
+const defaultURL = "https://www.comet.com/opik/api"
+
+// Config holds the resolved settings a Client uses to reach the Opik
+// backend and tag the data it sends.
+type Config struct {
+	// URL is the base URL of the Opik REST API.
+	URL string
+
+	// APIKey authenticates requests to Opik Cloud. Empty when talking to a
+	// self-hosted instance that doesn't require one.
+	APIKey string
+
+	// Workspace scopes requests to a specific Opik workspace.
+	Workspace string
+
+	// ProjectName tags every trace and span created by the Client.
+	ProjectName string
+
+	// OTLPEndpoint is the OpenTelemetry collector address used when the
+	// Client is configured with WithTransport(TransportOTLPGRPC).
+	OTLPEndpoint string
+}
+
+func defaultConfig() Config {
+	return Config{
+		URL:         envOrDefault("OPIK_URL_OVERRIDE", defaultURL),
+		APIKey:      os.Getenv("OPIK_API_KEY"),
+		Workspace:   os.Getenv("OPIK_WORKSPACE"),
+		ProjectName: "Default Project",
+	}
+}
+
+func envOrDefault(key, fallback string) string {
+	if v := os.Getenv(key); v != "" {
+		return v
+	}
+	return fallback
+}
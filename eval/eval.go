@@ -0,0 +1,88 @@
+// Package eval provides pluggable evaluators that score Opik spans as they
+// complete, submitting the results as feedback scores.
+package eval
+
+import (
+	"context"
+	"sync"
+)
+
+// SpanType mirrors the handful of span categories evaluators care about
+// (currently only LLM spans support scoring, but the set may grow).
+type SpanType string
+
+// SpanTypeLLM identifies a span produced by a call to a language model.
+const SpanTypeLLM SpanType = "llm"
+
+// SpanRecord is the read-only view of a completed span handed to evaluators.
+// It carries just enough of the span to score it, independent of the opik
+// package's internal representation.
+type SpanRecord struct {
+	TraceID string
+	SpanID  string
+	Name    string
+	Type    SpanType
+	Model   string
+	Input   map[string]any
+	Output  map[string]any
+}
+
+// Evaluator inspects a completed span and optionally produces a feedback
+// score for it. Returning a non-empty name submits {name, score, reason} as
+// a feedback score on the span; returning an empty name skips scoring (e.g.
+// the evaluator doesn't apply to this span). A non-nil err is reported in
+// that evaluator's Result and does not stop other evaluators from running.
+type Evaluator func(ctx context.Context, record SpanRecord) (name string, score float64, reason string, err error)
+
+// Result is the outcome of running a single Evaluator against a SpanRecord,
+// as reported back to the client so it can be submitted as a feedback score.
+type Result struct {
+	Name   string
+	Score  float64
+	Reason string
+	Err    error
+}
+
+// Run executes every evaluator in evaluators against record concurrently
+// using up to poolSize workers, and returns the results from evaluators
+// that produced one (empty-name, no-error results are dropped).
+func Run(ctx context.Context, evaluators []Evaluator, record SpanRecord, poolSize int) []Result {
+	if poolSize <= 0 {
+		poolSize = 1
+	}
+
+	jobs := make(chan Evaluator)
+
+	var mu sync.Mutex
+	var collected []Result
+
+	var workers sync.WaitGroup
+	for i := 0; i < poolSize; i++ {
+		workers.Add(1)
+		go func() {
+			defer workers.Done()
+			for e := range jobs {
+				name, score, reason, err := e(ctx, record)
+				if name == "" && err == nil {
+					continue
+				}
+				mu.Lock()
+				collected = append(collected, Result{Name: name, Score: score, Reason: reason, Err: err})
+				mu.Unlock()
+			}
+		}()
+	}
+
+dispatch:
+	for _, e := range evaluators {
+		select {
+		case jobs <- e:
+		case <-ctx.Done():
+			break dispatch
+		}
+	}
+	close(jobs)
+	workers.Wait()
+
+	return collected
+}
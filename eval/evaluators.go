@@ -0,0 +1,172 @@
+package eval
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+)
+
+// outputText pulls a best-effort plain-text representation out of a span's
+// output, looking first for a conventional "output"/"response"/"text" key
+// and falling back to the whole map's string form.
+func outputText(record SpanRecord) string {
+	for _, key := range []string{"output", "response", "text"} {
+		if v, ok := record.Output[key]; ok {
+			if s, ok := v.(string); ok {
+				return s
+			}
+		}
+	}
+	return fmt.Sprintf("%v", record.Output)
+}
+
+// ExactMatch scores 1.0 when the span's output text equals want exactly,
+// and 0.0 otherwise. Only applies to SpanTypeLLM spans.
+func ExactMatch(name, want string) Evaluator {
+	return func(ctx context.Context, record SpanRecord) (string, float64, string, error) {
+		if record.Type != SpanTypeLLM {
+			return "", 0, "", nil
+		}
+		got := outputText(record)
+		if got == want {
+			return name, 1.0, "exact match", nil
+		}
+		return name, 0.0, fmt.Sprintf("expected %q, got %q", want, got), nil
+	}
+}
+
+// RegexMatch scores 1.0 when the span's output text matches pattern, and 0.0
+// otherwise. Only applies to SpanTypeLLM spans.
+func RegexMatch(name, pattern string) Evaluator {
+	re := regexp.MustCompile(pattern)
+	return func(ctx context.Context, record SpanRecord) (string, float64, string, error) {
+		if record.Type != SpanTypeLLM {
+			return "", 0, "", nil
+		}
+		got := outputText(record)
+		if re.MatchString(got) {
+			return name, 1.0, "matched pattern", nil
+		}
+		return name, 0.0, fmt.Sprintf("output did not match pattern %q", pattern), nil
+	}
+}
+
+// JSONSchemaValidity scores 1.0 when the span's output text is valid JSON,
+// and 0.0 otherwise. It does not validate against a full JSON Schema
+// document; it checks structural validity, which is the common case for
+// "did the model return parseable JSON" checks.
+func JSONSchemaValidity(name string) Evaluator {
+	return func(ctx context.Context, record SpanRecord) (string, float64, string, error) {
+		if record.Type != SpanTypeLLM {
+			return "", 0, "", nil
+		}
+		got := outputText(record)
+		var v any
+		if err := json.Unmarshal([]byte(got), &v); err != nil {
+			return name, 0.0, fmt.Sprintf("invalid JSON: %v", err), nil
+		}
+		return name, 1.0, "valid JSON", nil
+	}
+}
+
+// TokenLengthBounds scores 1.0 when the span's output text's whitespace-
+// delimited token count falls within [min, max], and 0.0 otherwise. This is
+// a cheap proxy for true tokenization, intended to catch truncated or
+// runaway generations rather than to bill usage precisely.
+func TokenLengthBounds(name string, min, max int) Evaluator {
+	return func(ctx context.Context, record SpanRecord) (string, float64, string, error) {
+		if record.Type != SpanTypeLLM {
+			return "", 0, "", nil
+		}
+		got := strings.Fields(outputText(record))
+		n := len(got)
+		if n < min || n > max {
+			return name, 0.0, fmt.Sprintf("token count %d outside [%d, %d]", n, min, max), nil
+		}
+		return name, 1.0, fmt.Sprintf("token count %d within [%d, %d]", n, min, max), nil
+	}
+}
+
+// LLMJudgeConfig configures an LLM-as-judge evaluator: it sends the span's
+// input/output plus a grading rubric to an external model endpoint and
+// parses back a score.
+type LLMJudgeConfig struct {
+	// Endpoint is the URL of the judge model's chat/completion endpoint.
+	Endpoint string
+
+	// APIKey is sent as a Bearer token, if non-empty.
+	APIKey string
+
+	// Rubric is the grading instruction given to the judge model, e.g.
+	// "Score 0-1 how helpful this response is given the prompt."
+	Rubric string
+
+	// HTTPClient is used to call Endpoint. Defaults to http.DefaultClient.
+	HTTPClient *http.Client
+}
+
+type llmJudgeRequest struct {
+	Rubric string         `json:"rubric"`
+	Input  map[string]any `json:"input"`
+	Output map[string]any `json:"output"`
+}
+
+type llmJudgeResponse struct {
+	Score  float64 `json:"score"`
+	Reason string  `json:"reason"`
+}
+
+// LLMJudge returns an Evaluator that delegates scoring to an external model
+// endpoint, for grading criteria too subjective for exact/regex matching
+// (e.g. helpfulness, tone, faithfulness to a source document).
+func LLMJudge(name string, cfg LLMJudgeConfig) Evaluator {
+	client := cfg.HTTPClient
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	return func(ctx context.Context, record SpanRecord) (string, float64, string, error) {
+		if record.Type != SpanTypeLLM {
+			return "", 0, "", nil
+		}
+
+		body, err := json.Marshal(llmJudgeRequest{
+			Rubric: cfg.Rubric,
+			Input:  record.Input,
+			Output: record.Output,
+		})
+		if err != nil {
+			return name, 0, "", fmt.Errorf("opik/eval: encoding judge request: %w", err)
+		}
+
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, cfg.Endpoint, bytes.NewReader(body))
+		if err != nil {
+			return name, 0, "", fmt.Errorf("opik/eval: building judge request: %w", err)
+		}
+		req.Header.Set("Content-Type", "application/json")
+		if cfg.APIKey != "" {
+			req.Header.Set("Authorization", "Bearer "+cfg.APIKey)
+		}
+
+		resp, err := client.Do(req)
+		if err != nil {
+			return name, 0, "", fmt.Errorf("opik/eval: calling judge endpoint: %w", err)
+		}
+		defer resp.Body.Close()
+
+		if resp.StatusCode != http.StatusOK {
+			return name, 0, "", fmt.Errorf("opik/eval: judge endpoint returned %s", resp.Status)
+		}
+
+		var judged llmJudgeResponse
+		if err := json.NewDecoder(resp.Body).Decode(&judged); err != nil {
+			return name, 0, "", fmt.Errorf("opik/eval: decoding judge response: %w", err)
+		}
+
+		return name, judged.Score, judged.Reason, nil
+	}
+}
@@ -0,0 +1,51 @@
+package opik
+
+import (
+	"context"
+
+	"github.com/agentplexus/go-comet-ml-opik/eval"
+)
+
+// evalWorkerPoolSize bounds how many evaluators run concurrently per span.
+// It's a constant rather than configurable for now, matching the simplicity
+// of the built-in evaluators; revisit if a use case needs more control.
+const evalWorkerPoolSize = 4
+
+// WithEvaluators registers evaluator functions that run automatically
+// whenever a matching span ends. Each evaluator's result is submitted via
+// the same AddFeedbackScore path a caller would use manually.
+func WithEvaluators(evaluators ...eval.Evaluator) ClientOption {
+	return func(c *Client) error {
+		c.evaluators = append(c.evaluators, evaluators...)
+		return nil
+	}
+}
+
+// runEvaluators runs every registered evaluator against s's completed state
+// and submits any resulting scores as feedback; results carrying an error
+// are skipped without being submitted or logged. It's called from span.End
+// after the span has been marked complete, so evaluators see final input
+// and output.
+func (c *Client) runEvaluators(ctx context.Context, s *Span) {
+	if len(c.evaluators) == 0 {
+		return
+	}
+
+	record := eval.SpanRecord{
+		TraceID: s.data.traceID,
+		SpanID:  s.data.id,
+		Name:    s.data.name,
+		Type:    eval.SpanType(s.data.spanType),
+		Model:   s.data.model,
+		Input:   s.data.input,
+		Output:  s.data.output,
+	}
+
+	results := eval.Run(ctx, c.evaluators, record, evalWorkerPoolSize)
+	for _, r := range results {
+		if r.Err != nil {
+			continue
+		}
+		_ = s.AddFeedbackScore(ctx, r.Name, r.Score, r.Reason)
+	}
+}
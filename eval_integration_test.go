@@ -0,0 +1,51 @@
+package opik
+
+import (
+	"context"
+	"testing"
+
+	"github.com/agentplexus/go-comet-ml-opik/eval"
+)
+
+func TestIntegration_Evaluators(t *testing.T) {
+	client, _ := newTestClient(t, WithEvaluators(
+		eval.RegexMatch("contains-hello", "(?i)hello"),
+	))
+
+	ctx := context.Background()
+
+	trace, err := client.Trace(ctx, "evaluators-trace")
+	if err != nil {
+		t.Fatalf("Failed to create trace: %v", err)
+	}
+
+	span, err := trace.Span(ctx, "evaluators-span", WithSpanType(SpanTypeLLM))
+	if err != nil {
+		t.Fatalf("Failed to create span: %v", err)
+	}
+
+	if err := span.End(ctx, WithSpanOutput(map[string]any{"output": "hello from the model"})); err != nil {
+		t.Fatalf("Failed to end span: %v", err)
+	}
+	if err := trace.End(ctx); err != nil {
+		t.Fatalf("Failed to end trace: %v", err)
+	}
+
+	fetched, err := client.GetSpan(ctx, span.ID())
+	if err != nil {
+		t.Fatalf("Failed to fetch span: %v", err)
+	}
+
+	found := false
+	for _, score := range fetched.FeedbackScores {
+		if score.Name == "contains-hello" {
+			found = true
+			if score.Value != 1.0 {
+				t.Fatalf("expected contains-hello score of 1.0, got %v", score.Value)
+			}
+		}
+	}
+	if !found {
+		t.Fatal("expected evaluator-produced feedback score to be visible on the span")
+	}
+}
@@ -0,0 +1,23 @@
+package opik
+
+import "context"
+
+// FeedbackScore is a named, numeric judgment attached to a trace or span,
+// such as a human rating or an automated evaluator's verdict.
+type FeedbackScore struct {
+	Name   string  `json:"name"`
+	Value  float64 `json:"value"`
+	Reason string  `json:"reason,omitempty"`
+}
+
+// AddFeedbackScore attaches a named score to the span, with an optional
+// reason explaining it.
+func (s *Span) AddFeedbackScore(ctx context.Context, name string, score float64, reason string) error {
+	s.client.otel.recordMirrorFeedback(s.data.id, name, score, reason)
+
+	return s.client.submitFeedback(ctx, s.data.traceID, s.data.id, FeedbackScore{
+		Name:   name,
+		Value:  score,
+		Reason: reason,
+	})
+}
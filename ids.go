@@ -0,0 +1,15 @@
+package opik
+
+import "github.com/google/uuid"
+
+// newID generates a UUID v7 identifier: time-ordered, so traces and spans
+// sort naturally by creation time.
+func newID() string {
+	id, err := uuid.NewV7()
+	if err != nil {
+		// uuid.NewV7 only fails if the OS entropy source is broken; fall
+		// back to a random v4 rather than propagating that as an SDK error.
+		return uuid.NewString()
+	}
+	return id.String()
+}
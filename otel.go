@@ -0,0 +1,185 @@
+package opik
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// traceParentPropagator decodes the W3C traceparent header format used by
+// the TRACEPARENT environment variable.
+var traceParentPropagator = propagation.TraceContext{}
+
+// Attribute keys used to record OpenTelemetry identity on Opik traces and
+// spans so the two systems can be cross-referenced after the fact.
+const (
+	otelTraceIDAttr      = "otel.trace_id"
+	otelParentSpanIDAttr = "otel.parent_span_id"
+
+	// traceParentEnvVar is the standard W3C Trace Context environment
+	// variable read each time Client.Trace starts a root trace, to seed it.
+	traceParentEnvVar = "TRACEPARENT"
+)
+
+// otelBridge wraps a trace.Tracer and mirrors every Opik trace/span onto it.
+// The mirrored spans get their own OTel-assigned trace/span IDs (the
+// supplied trace.TracerProvider owns ID generation, not this package) but
+// preserve the same parent/child nesting as the Opik trace/span tree. The
+// other direction is also covered: whatever OTel span context is already on
+// the ctx passed to Client.Trace (e.g. seeded from TRACEPARENT, or an
+// ambient caller span) is recorded as otel.trace_id/otel.parent_span_id
+// metadata on the Opik trace, so the two systems can still be
+// cross-referenced by ID after the fact. Spans record the OTel span context
+// of their trace instead (the trace's mirrored span when a bridge is
+// installed); the ctx passed to Trace.Span is not consulted for it.
+type otelBridge struct {
+	tracer trace.Tracer
+
+	mu    sync.Mutex
+	spans map[string]trace.Span // keyed by Opik span/trace ID
+}
+
+// WithOTelBridge installs a trace.TracerProvider so that every Opik trace and
+// span also emits a mirrored OpenTelemetry span with matching start/end
+// times, input/output recorded as attributes, feedback scores added before
+// the span ends recorded as events (scores added after End, including those
+// from WithEvaluators, are not mirrored), and the same parent/child
+// nesting — though the mirrored span's own
+// TraceID/SpanID are assigned by tp, not forced to equal the Opik trace/span
+// ID it mirrors.
+func WithOTelBridge(tp trace.TracerProvider) ClientOption {
+	return func(c *Client) error {
+		c.otel = &otelBridge{
+			tracer: tp.Tracer("github.com/agentplexus/go-comet-ml-opik"),
+			spans:  make(map[string]trace.Span),
+		}
+		return nil
+	}
+}
+
+// otelSpanContextFromEnv seeds a root trace.SpanContext from the TRACEPARENT
+// environment variable, if one is present and valid. This lets a process
+// started by an already-instrumented parent (e.g. a shell script exported by
+// a collector) continue that trace instead of starting a new one.
+func otelSpanContextFromEnv() (trace.SpanContext, bool) {
+	tp := os.Getenv(traceParentEnvVar)
+	if tp == "" {
+		return trace.SpanContext{}, false
+	}
+	// TRACEPARENT is carried as an HTTP-style header value; reuse the
+	// propagator's wire format by stuffing it into a carrier the
+	// textproto propagator understands.
+	ctx := traceParentPropagator.Extract(context.Background(), mapCarrier{"traceparent": tp})
+	sc := trace.SpanContextFromContext(ctx)
+	if !sc.IsValid() {
+		return trace.SpanContext{}, false
+	}
+	return sc, true
+}
+
+// mapCarrier adapts a plain map to the otel propagation.TextMapCarrier
+// interface used only for parsing the TRACEPARENT env var.
+type mapCarrier map[string]string
+
+func (m mapCarrier) Get(key string) string { return m[key] }
+func (m mapCarrier) Set(key, value string) { m[key] = value }
+func (m mapCarrier) Keys() []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+// contextWithOTelSpanContext attaches sc to ctx using the same mechanism
+// go.opentelemetry.io/otel/trace uses, so trace.SpanContextFromContext (and
+// otelAttributesFromContext below) can recover it later.
+func contextWithOTelSpanContext(ctx context.Context, sc trace.SpanContext) context.Context {
+	return trace.ContextWithSpanContext(ctx, sc)
+}
+
+// otelAttributesFromContext extracts the OTel TraceID/SpanID carried on ctx,
+// if any, so callers can stamp them onto an Opik trace or span as attributes.
+func otelAttributesFromContext(ctx context.Context) map[string]any {
+	sc := trace.SpanContextFromContext(ctx)
+	if !sc.IsValid() {
+		return nil
+	}
+	attrs := map[string]any{
+		otelTraceIDAttr: sc.TraceID().String(),
+	}
+	if sc.SpanID().IsValid() {
+		attrs[otelParentSpanIDAttr] = sc.SpanID().String()
+	}
+	return attrs
+}
+
+// startMirrorSpan starts (or no-ops, if no bridge is installed) the shadow
+// OTel span for an Opik trace/span identified by id, returning the context
+// that downstream children should use so parent linkage is preserved.
+func (b *otelBridge) startMirrorSpan(ctx context.Context, id, name string, input map[string]any) context.Context {
+	if b == nil {
+		return ctx
+	}
+	spanCtx, span := b.tracer.Start(ctx, name)
+	if input != nil {
+		span.SetAttributes(attributesFromMap("input", input)...)
+	}
+	b.mu.Lock()
+	b.spans[id] = span
+	b.mu.Unlock()
+	return spanCtx
+}
+
+// endMirrorSpan ends the shadow OTel span for id, recording output.
+func (b *otelBridge) endMirrorSpan(id string, output map[string]any) {
+	if b == nil {
+		return
+	}
+	b.mu.Lock()
+	span, ok := b.spans[id]
+	delete(b.spans, id)
+	b.mu.Unlock()
+	if !ok {
+		return
+	}
+	if output != nil {
+		span.SetAttributes(attributesFromMap("output", output)...)
+	}
+	span.End()
+}
+
+// recordMirrorFeedback adds a feedback score as an event on the shadow OTel
+// span for id, if a bridge is installed and the span is still open.
+func (b *otelBridge) recordMirrorFeedback(id, name string, score float64, reason string) {
+	if b == nil {
+		return
+	}
+	b.mu.Lock()
+	span, ok := b.spans[id]
+	b.mu.Unlock()
+	if !ok {
+		return
+	}
+	span.AddEvent("feedback_score", trace.WithAttributes(
+		attribute.String("name", name),
+		attribute.Float64("score", score),
+		attribute.String("reason", reason),
+	))
+}
+
+// attributesFromMap flattens a map[string]any into OTel attributes under the
+// given prefix, using each value's fmt representation. Opik payloads are
+// already JSON-shaped, so this is intentionally shallow.
+func attributesFromMap(prefix string, m map[string]any) []attribute.KeyValue {
+	attrs := make([]attribute.KeyValue, 0, len(m))
+	for k, v := range m {
+		attrs = append(attrs, attribute.String(prefix+"."+k, fmt.Sprintf("%v", v)))
+	}
+	return attrs
+}
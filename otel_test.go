@@ -0,0 +1,71 @@
+package opik
+
+import (
+	"context"
+	"testing"
+
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestIntegration_OTelBridge(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+	defer tp.Shutdown(context.Background())
+
+	client, server := newTestClient(t, WithOTelBridge(tp))
+
+	ctx := context.Background()
+
+	trace, err := client.Trace(ctx, "otel-bridge-trace",
+		WithTraceInput(map[string]any{"test": "otel"}),
+	)
+	if err != nil {
+		t.Fatalf("Failed to create trace: %v", err)
+	}
+
+	span, err := trace.Span(ctx, "otel-bridge-span", WithSpanType(SpanTypeLLM))
+	if err != nil {
+		t.Fatalf("Failed to create span: %v", err)
+	}
+
+	if err := span.End(ctx, WithSpanOutput(map[string]any{"response": "ok"})); err != nil {
+		t.Fatalf("Failed to end span: %v", err)
+	}
+	if err := trace.End(ctx, WithTraceOutput(map[string]any{"result": "success"})); err != nil {
+		t.Fatalf("Failed to end trace: %v", err)
+	}
+
+	spans := exporter.GetSpans()
+	if len(spans) != 2 {
+		t.Fatalf("expected 2 mirrored OTel spans (trace + span), got %d", len(spans))
+	}
+
+	var traceSpan, childSpan tracetest.SpanStub
+	for _, s := range spans {
+		if s.Name == "otel-bridge-trace" {
+			traceSpan = s
+		}
+		if s.Name == "otel-bridge-span" {
+			childSpan = s
+		}
+	}
+
+	if childSpan.Parent.SpanID() != traceSpan.SpanContext.SpanID() {
+		t.Fatalf("expected span %q to be a child of trace %q, got parent %s want %s",
+			childSpan.Name, traceSpan.Name, childSpan.Parent.SpanID(), traceSpan.SpanContext.SpanID())
+	}
+
+	// The Opik span's own payload should carry the mirrored OTel trace's
+	// IDs as metadata, so the two systems can be cross-referenced by ID.
+	server.mu.Lock()
+	submitted := server.spans[span.ID()]
+	server.mu.Unlock()
+	metadata, _ := submitted["metadata"].(map[string]any)
+	if got := metadata[otelTraceIDAttr]; got != traceSpan.SpanContext.TraceID().String() {
+		t.Fatalf("expected span metadata[%q] = %q, got %q", otelTraceIDAttr, traceSpan.SpanContext.TraceID().String(), got)
+	}
+	if got := metadata[otelParentSpanIDAttr]; got != traceSpan.SpanContext.SpanID().String() {
+		t.Fatalf("expected span metadata[%q] = %q, got %q", otelParentSpanIDAttr, traceSpan.SpanContext.SpanID().String(), got)
+	}
+}
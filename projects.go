@@ -0,0 +1,28 @@
+package opik
+
+import (
+	"context"
+	"fmt"
+)
+
+// Project is an Opik project: the top-level container traces and spans are
+// grouped under.
+type Project struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+type listProjectsResponse struct {
+	Content []Project `json:"content"`
+}
+
+// ListProjects returns page (1-indexed) of at most size projects visible to
+// this Client's workspace.
+func (c *Client) ListProjects(ctx context.Context, page, size int) ([]Project, error) {
+	var resp listProjectsResponse
+	path := fmt.Sprintf("/v1/private/projects?page=%d&size=%d", page, size)
+	if err := doJSON(ctx, c.config, "GET", path, nil, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Content, nil
+}
@@ -0,0 +1,138 @@
+package opik
+
+import (
+	"context"
+	"sync"
+)
+
+// SpanType categorizes what kind of work a span represents.
+type SpanType string
+
+const (
+	SpanTypeGeneral SpanType = "general"
+	SpanTypeLLM     SpanType = "llm"
+	SpanTypeTool    SpanType = "tool"
+)
+
+// spanData is the mutable, internal representation of a span. Span exposes
+// a narrow, safe-for-concurrent-use API on top of it.
+type spanData struct {
+	id       string
+	traceID  string
+	name     string
+	spanType SpanType
+	model    string
+	provider string
+	input    map[string]any
+	output   map[string]any
+	links    []Link
+	metadata map[string]any
+}
+
+func spanWirePayload(s *spanData) map[string]any {
+	return map[string]any{
+		"id":       s.id,
+		"trace_id": s.traceID,
+		"name":     s.name,
+		"type":     s.spanType,
+		"model":    s.model,
+		"provider": s.provider,
+		"input":    s.input,
+		"output":   s.output,
+		"links":    s.links,
+		"metadata": s.metadata,
+	}
+}
+
+// SpanOption configures a span at creation time (via Trace.Span) or updates
+// it when passed to Span.End.
+type SpanOption func(*spanData)
+
+// WithSpanType sets what kind of work the span represents. Defaults to
+// SpanTypeGeneral.
+func WithSpanType(t SpanType) SpanOption {
+	return func(s *spanData) { s.spanType = t }
+}
+
+// WithSpanModel records which model served an LLM span.
+func WithSpanModel(model string) SpanOption {
+	return func(s *spanData) { s.model = model }
+}
+
+// WithSpanProvider records which provider served an LLM span (e.g. "openai").
+func WithSpanProvider(provider string) SpanOption {
+	return func(s *spanData) { s.provider = provider }
+}
+
+// WithSpanInput sets the span's recorded input.
+func WithSpanInput(input map[string]any) SpanOption {
+	return func(s *spanData) { s.input = input }
+}
+
+// WithSpanOutput sets the span's recorded output. Typically passed to
+// Span.End once the span's work has finished.
+func WithSpanOutput(output map[string]any) SpanOption {
+	return func(s *spanData) { s.output = output }
+}
+
+// Span represents one unit of work within a Trace, such as a single LLM
+// call or tool invocation.
+type Span struct {
+	client *Client
+
+	mu   sync.Mutex
+	data *spanData
+}
+
+// newSpan is the shared constructor used by Trace.Span; ctx should already
+// carry the parent's OTel span context, if any, so the mirrored OTel span
+// nests correctly.
+func (c *Client) newSpan(ctx context.Context, traceID, name string, opts ...SpanOption) (*Span, error) {
+	data := &spanData{
+		id:       newID(),
+		traceID:  traceID,
+		name:     name,
+		spanType: SpanTypeGeneral,
+		// With a bridge installed, ctx carries the parent's mirrored OTel
+		// span context (see startMirrorSpan below), so this records that
+		// parent's OTel trace/span ID for cross-referencing; otherwise it
+		// records whatever OTel span context the trace was created under.
+		metadata: otelAttributesFromContext(ctx),
+	}
+	for _, opt := range opts {
+		opt(data)
+	}
+
+	c.otel.startMirrorSpan(ctx, data.id, name, data.input)
+
+	if err := c.submitSpan(ctx, data); err != nil {
+		return nil, err
+	}
+
+	return &Span{client: c, data: data}, nil
+}
+
+// ID returns the span's unique identifier.
+func (s *Span) ID() string {
+	return s.data.id
+}
+
+// End marks the span complete, applying any final options (typically
+// WithSpanOutput) before submitting it.
+func (s *Span) End(ctx context.Context, opts ...SpanOption) error {
+	s.mu.Lock()
+	for _, opt := range opts {
+		opt(s.data)
+	}
+	data := *s.data
+	s.mu.Unlock()
+
+	s.client.otel.endMirrorSpan(data.id, data.output)
+
+	if err := s.client.submitSpan(ctx, &data); err != nil {
+		return err
+	}
+
+	s.client.runEvaluators(ctx, s)
+	return nil
+}
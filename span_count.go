@@ -0,0 +1,22 @@
+package opik
+
+import (
+	"context"
+	"fmt"
+)
+
+type spanCountResponse struct {
+	Count int `json:"count"`
+}
+
+// CountSpans returns how many spans the backend currently has recorded
+// under the given trace. It's mainly useful in tests asserting that a
+// batch of spans all arrived.
+func (c *Client) CountSpans(ctx context.Context, traceID string) (int, error) {
+	var resp spanCountResponse
+	path := fmt.Sprintf("/v1/private/traces/%s/spans/count", traceID)
+	if err := doJSON(ctx, c.config, "GET", path, nil, &resp); err != nil {
+		return 0, err
+	}
+	return resp.Count, nil
+}
@@ -0,0 +1,47 @@
+package opik
+
+import (
+	"context"
+	"fmt"
+)
+
+// Link expresses a causal relationship from a span to another span, possibly
+// in a different trace, without nesting the two. Typical uses are a retry
+// span linking back to the span it retried, or a batch job span linking to
+// each of the per-item request traces it kicked off.
+type Link struct {
+	TraceID    string         `json:"trace_id"`
+	SpanID     string         `json:"span_id"`
+	Attributes map[string]any `json:"attributes,omitempty"`
+}
+
+// WithSpanLinks attaches one or more links to a span at creation time.
+func WithSpanLinks(links ...Link) SpanOption {
+	return func(s *spanData) {
+		s.links = append(s.links, links...)
+	}
+}
+
+// AddLink records a link from span to the span identified by
+// (targetTraceID, targetSpanID), with optional attributes describing the
+// relationship (e.g. {"relationship": "retry_of"}). AddLink submits the span
+// with the new link right away, and the link is queryable on retrieval
+// alongside the span's other fields.
+func (s *Span) AddLink(ctx context.Context, targetTraceID, targetSpanID string, attrs map[string]any) error {
+	if targetTraceID == "" || targetSpanID == "" {
+		return fmt.Errorf("opik: AddLink requires both a target trace ID and span ID")
+	}
+
+	link := Link{
+		TraceID:    targetTraceID,
+		SpanID:     targetSpanID,
+		Attributes: attrs,
+	}
+
+	s.mu.Lock()
+	s.data.links = append(s.data.links, link)
+	data := *s.data
+	s.mu.Unlock()
+
+	return s.client.submitSpan(ctx, &data)
+}
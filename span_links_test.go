@@ -0,0 +1,57 @@
+package opik
+
+import (
+	"context"
+	"testing"
+)
+
+func TestIntegration_SpanLinks(t *testing.T) {
+	client, _ := newTestClient(t)
+	ctx := context.Background()
+
+	original, err := client.Trace(ctx, "span-links-original-trace")
+	if err != nil {
+		t.Fatalf("Failed to create original trace: %v", err)
+	}
+	originalSpan, err := original.Span(ctx, "original-request", WithSpanType(SpanTypeLLM))
+	if err != nil {
+		t.Fatalf("Failed to create original span: %v", err)
+	}
+	if err := originalSpan.End(ctx); err != nil {
+		t.Fatalf("Failed to end original span: %v", err)
+	}
+
+	retry, err := client.Trace(ctx, "span-links-retry-trace")
+	if err != nil {
+		t.Fatalf("Failed to create retry trace: %v", err)
+	}
+	retrySpan, err := retry.Span(ctx, "retry-request",
+		WithSpanType(SpanTypeLLM),
+		WithSpanLinks(Link{
+			TraceID:    original.ID(),
+			SpanID:     originalSpan.ID(),
+			Attributes: map[string]any{"relationship": "retry_of"},
+		}),
+	)
+	if err != nil {
+		t.Fatalf("Failed to create retry span: %v", err)
+	}
+
+	if err := retrySpan.AddLink(ctx, original.ID(), originalSpan.ID(), map[string]any{"relationship": "retry_of"}); err != nil {
+		t.Fatalf("Failed to add link: %v", err)
+	}
+	if err := retrySpan.End(ctx); err != nil {
+		t.Fatalf("Failed to end retry span: %v", err)
+	}
+
+	fetched, err := client.GetSpan(ctx, retrySpan.ID())
+	if err != nil {
+		t.Fatalf("Failed to fetch retry span: %v", err)
+	}
+	if len(fetched.Links) == 0 {
+		t.Fatal("expected retrieved span to round-trip at least one link")
+	}
+	if fetched.Links[0].TraceID != original.ID() || fetched.Links[0].SpanID != originalSpan.ID() {
+		t.Fatalf("link did not round-trip, got %+v", fetched.Links[0])
+	}
+}
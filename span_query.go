@@ -0,0 +1,52 @@
+package opik
+
+import "context"
+
+// SpanSnapshot is the read-only view of a span returned by Client.GetSpan,
+// reflecting whatever has been submitted to the backend so far.
+type SpanSnapshot struct {
+	ID             string
+	TraceID        string
+	Name           string
+	Type           SpanType
+	Model          string
+	Provider       string
+	Input          map[string]any
+	Output         map[string]any
+	Links          []Link
+	FeedbackScores []FeedbackScore
+}
+
+type spanWireResponse struct {
+	ID             string          `json:"id"`
+	TraceID        string          `json:"trace_id"`
+	Name           string          `json:"name"`
+	Type           SpanType        `json:"type"`
+	Model          string          `json:"model"`
+	Provider       string          `json:"provider"`
+	Input          map[string]any  `json:"input"`
+	Output         map[string]any  `json:"output"`
+	Links          []Link          `json:"links"`
+	FeedbackScores []FeedbackScore `json:"feedback_scores"`
+}
+
+// GetSpan retrieves a span by ID, including any links and feedback scores
+// recorded against it.
+func (c *Client) GetSpan(ctx context.Context, id string) (*SpanSnapshot, error) {
+	var resp spanWireResponse
+	if err := doJSON(ctx, c.config, "GET", "/v1/private/spans/"+id, nil, &resp); err != nil {
+		return nil, err
+	}
+	return &SpanSnapshot{
+		ID:             resp.ID,
+		TraceID:        resp.TraceID,
+		Name:           resp.Name,
+		Type:           resp.Type,
+		Model:          resp.Model,
+		Provider:       resp.Provider,
+		Input:          resp.Input,
+		Output:         resp.Output,
+		Links:          resp.Links,
+		FeedbackScores: resp.FeedbackScores,
+	}, nil
+}
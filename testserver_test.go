@@ -0,0 +1,136 @@
+package opik
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// mockOpikServer is an in-memory stand-in for the Opik REST API, used by
+// this package's integration tests so they exercise the real HTTP request
+// path without depending on OPIK_API_KEY/OPIK_WORKSPACE being set.
+type mockOpikServer struct {
+	*httptest.Server
+
+	mu             sync.Mutex
+	traces         map[string]map[string]any
+	spans          map[string]map[string]any
+	feedbackScores map[string][]FeedbackScore
+}
+
+func newMockOpikServer(t *testing.T) *mockOpikServer {
+	t.Helper()
+
+	m := &mockOpikServer{
+		traces:         make(map[string]map[string]any),
+		spans:          make(map[string]map[string]any),
+		feedbackScores: make(map[string][]FeedbackScore),
+	}
+	m.Server = httptest.NewServer(http.HandlerFunc(m.handle))
+	t.Cleanup(m.Close)
+	return m
+}
+
+func (m *mockOpikServer) handle(w http.ResponseWriter, r *http.Request) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	path := strings.TrimPrefix(r.URL.Path, "/v1/private/")
+
+	switch {
+	case r.Method == http.MethodGet && strings.HasPrefix(path, "traces/") && strings.HasSuffix(path, "/spans/count"):
+		traceID := strings.TrimSuffix(strings.TrimPrefix(path, "traces/"), "/spans/count")
+		count := 0
+		for _, s := range m.spans {
+			if id, _ := s["trace_id"].(string); id == traceID {
+				count++
+			}
+		}
+		json.NewEncoder(w).Encode(map[string]int{"count": count})
+
+	case r.Method == http.MethodPut && strings.HasSuffix(path, "/feedback-scores"):
+		id := strings.TrimSuffix(strings.TrimPrefix(path, "spans/"), "/feedback-scores")
+		var score FeedbackScore
+		json.NewDecoder(r.Body).Decode(&score)
+		m.feedbackScores[id] = append(m.feedbackScores[id], score)
+		w.WriteHeader(http.StatusNoContent)
+
+	case r.Method == http.MethodPut && strings.HasPrefix(path, "traces/"):
+		id := strings.TrimPrefix(path, "traces/")
+		var payload map[string]any
+		json.NewDecoder(r.Body).Decode(&payload)
+		m.traces[id] = payload
+		w.WriteHeader(http.StatusNoContent)
+
+	case r.Method == http.MethodGet && strings.HasPrefix(path, "spans/"):
+		id := strings.TrimPrefix(path, "spans/")
+		span, ok := m.spans[id]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		resp := make(map[string]any, len(span)+1)
+		for k, v := range span {
+			resp[k] = v
+		}
+		resp["feedback_scores"] = m.feedbackScores[id]
+		json.NewEncoder(w).Encode(resp)
+
+	case r.Method == http.MethodPut && strings.HasPrefix(path, "spans/"):
+		id := strings.TrimPrefix(path, "spans/")
+		var payload map[string]any
+		json.NewDecoder(r.Body).Decode(&payload)
+		m.spans[id] = payload
+		w.WriteHeader(http.StatusNoContent)
+
+	case r.Method == http.MethodPost && (path == "traces/batch" || path == "spans/batch"):
+		var body struct {
+			Items []map[string]any `json:"items"`
+		}
+		json.NewDecoder(r.Body).Decode(&body)
+		for _, item := range body.Items {
+			id, _ := item["id"].(string)
+			if id == "" {
+				continue
+			}
+			if path == "traces/batch" {
+				m.traces[id] = item
+			} else {
+				m.spans[id] = item
+			}
+		}
+		w.WriteHeader(http.StatusNoContent)
+
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+// newTestClient returns a Client wired up against a freshly started
+// mockOpikServer, plus the server itself for assertions.
+func newTestClient(t *testing.T, opts ...ClientOption) (*Client, *mockOpikServer) {
+	t.Helper()
+
+	server := newMockOpikServer(t)
+	allOpts := append([]ClientOption{
+		WithProjectName("go-sdk-integration-tests"),
+		withBaseURL(server.URL),
+	}, opts...)
+
+	client, err := NewClient(allOpts...)
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+	return client, server
+}
+
+// withBaseURL points a Client at a non-default backend, for tests.
+func withBaseURL(url string) ClientOption {
+	return func(c *Client) error {
+		c.config.URL = url
+		return nil
+	}
+}
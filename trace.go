@@ -0,0 +1,112 @@
+package opik
+
+import (
+	"context"
+	"sync"
+)
+
+// traceData is the mutable, internal representation of a trace. Trace
+// exposes a narrow, safe-for-concurrent-use API on top of it.
+type traceData struct {
+	id          string
+	projectName string
+	name        string
+	input       map[string]any
+	output      map[string]any
+	tags        []string
+	metadata    map[string]any
+}
+
+func traceWirePayload(t *traceData) map[string]any {
+	return map[string]any{
+		"id":           t.id,
+		"project_name": t.projectName,
+		"name":         t.name,
+		"input":        t.input,
+		"output":       t.output,
+		"tags":         t.tags,
+		"metadata":     t.metadata,
+	}
+}
+
+// TraceOption configures a trace at creation time (via Client.Trace) or
+// updates it when passed to Trace.End.
+type TraceOption func(*traceData)
+
+// WithTraceInput sets the trace's recorded input.
+func WithTraceInput(input map[string]any) TraceOption {
+	return func(t *traceData) { t.input = input }
+}
+
+// WithTraceOutput sets the trace's recorded output. Typically passed to
+// Trace.End once the traced operation has finished.
+func WithTraceOutput(output map[string]any) TraceOption {
+	return func(t *traceData) { t.output = output }
+}
+
+// WithTraceTags appends tags to the trace.
+func WithTraceTags(tags ...string) TraceOption {
+	return func(t *traceData) { t.tags = append(t.tags, tags...) }
+}
+
+// Trace represents a single top-level execution, such as one request
+// through an LLM application, that spans can be nested under.
+type Trace struct {
+	client *Client
+
+	mu   sync.Mutex
+	data *traceData
+
+	otelCtx context.Context
+}
+
+// Trace creates and starts a new trace named name.
+func (c *Client) Trace(ctx context.Context, name string, opts ...TraceOption) (*Trace, error) {
+	ctx = c.rootContext(ctx)
+
+	data := &traceData{
+		id:          newID(),
+		projectName: c.config.ProjectName,
+		name:        name,
+		// Record whatever OTel span context ctx already carries (e.g. seeded
+		// from TRACEPARENT, or an ambient span from the caller) so the trace
+		// can be cross-referenced back to that OTel trace/span by ID.
+		metadata: otelAttributesFromContext(ctx),
+	}
+	for _, opt := range opts {
+		opt(data)
+	}
+
+	otelCtx := c.otel.startMirrorSpan(ctx, data.id, name, data.input)
+
+	if err := c.submitTrace(ctx, data); err != nil {
+		return nil, err
+	}
+
+	return &Trace{client: c, data: data, otelCtx: otelCtx}, nil
+}
+
+// ID returns the trace's unique identifier.
+func (t *Trace) ID() string {
+	return t.data.id
+}
+
+// Span starts a new span nested under this trace.
+func (t *Trace) Span(ctx context.Context, name string, opts ...SpanOption) (*Span, error) {
+	return t.client.newSpan(t.otelCtx, t.data.id, name, opts...)
+}
+
+// End marks the trace complete, applying any final options (typically
+// WithTraceOutput) before submitting it.
+func (t *Trace) End(ctx context.Context, opts ...TraceOption) error {
+	t.mu.Lock()
+	for _, opt := range opts {
+		opt(t.data)
+	}
+	data := *t.data
+	t.mu.Unlock()
+
+	t.client.otel.endMirrorSpan(data.id, data.output)
+
+	return t.client.submitTrace(ctx, &data)
+}
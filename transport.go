@@ -0,0 +1,74 @@
+package opik
+
+import (
+	"context"
+	"errors"
+)
+
+var errInvalidTransportKind = errors.New("opik: invalid transport kind")
+
+// TransportKind selects the wire protocol a Client uses to deliver traces,
+// spans, and feedback scores to Opik.
+type TransportKind int
+
+const (
+	// TransportREST sends each payload as an HTTP request to the Opik REST
+	// API. This is the default and requires no additional infrastructure.
+	TransportREST TransportKind = iota
+
+	// TransportOTLPGRPC sends payloads as OTLP/gRPC to an OpenTelemetry
+	// collector, which is expected to forward them on to Opik. This suits
+	// deployments that already run an otel-collector sidecar and want to
+	// reuse its batching, retry, and routing configuration.
+	TransportOTLPGRPC
+)
+
+// Transport abstracts how a Client delivers data to the backend, so the REST
+// and OTLP/gRPC implementations can share the same trace/span lifecycle
+// code in trace.go and span.go.
+type Transport interface {
+	// SubmitTrace creates or updates a trace.
+	SubmitTrace(ctx context.Context, t *traceData) error
+
+	// SubmitSpan creates or updates a span.
+	SubmitSpan(ctx context.Context, s *spanData) error
+
+	// SubmitFeedback records a feedback score against the span identified
+	// by (traceID, spanID). Both IDs are needed so transports that encode
+	// OTLP identifiers (which are derived differently for traces and
+	// spans) can address the right entity.
+	SubmitFeedback(ctx context.Context, traceID, spanID string, score FeedbackScore) error
+
+	// Close releases any resources (connections, goroutines) held by the
+	// transport.
+	Close() error
+}
+
+// WithTransport selects the wire protocol used to deliver traces, spans, and
+// feedback scores. The default, if this option is not supplied, is
+// TransportREST. TransportOTLPGRPC additionally requires an endpoint to dial,
+// set via WithOTLPEndpoint; ClientOptions apply in order, so WithOTLPEndpoint
+// may be passed either before or after this option.
+func WithTransport(kind TransportKind) ClientOption {
+	return func(c *Client) error {
+		switch kind {
+		case TransportREST:
+			c.transport = newRESTTransport(c.config)
+		case TransportOTLPGRPC:
+			c.pendingTransportKind = &kind
+		default:
+			return errInvalidTransportKind
+		}
+		return nil
+	}
+}
+
+// WithOTLPEndpoint sets the OpenTelemetry collector address dialed by
+// TransportOTLPGRPC (e.g. "localhost:4317"). Required when combined with
+// WithTransport(TransportOTLPGRPC); ignored otherwise.
+func WithOTLPEndpoint(addr string) ClientOption {
+	return func(c *Client) error {
+		c.config.OTLPEndpoint = addr
+		return nil
+	}
+}
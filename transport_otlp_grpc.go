@@ -0,0 +1,170 @@
+package opik
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	coltracepb "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	commonpb "go.opentelemetry.io/proto/otlp/common/v1"
+	tracepb "go.opentelemetry.io/proto/otlp/trace/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// otlpGRPCTransport sends traces and spans as OTLP/gRPC ExportTraceServiceRequests
+// to an OpenTelemetry collector, which is expected to be configured to
+// forward them on to Opik. Feedback scores are encoded as span events, since
+// OTLP has no native feedback-score concept.
+type otlpGRPCTransport struct {
+	conn   *grpc.ClientConn
+	client coltracepb.TraceServiceClient
+}
+
+func newOTLPGRPCTransport(cfg Config) (Transport, error) {
+	if cfg.OTLPEndpoint == "" {
+		return nil, fmt.Errorf("opik: TransportOTLPGRPC requires Config.OTLPEndpoint")
+	}
+
+	conn, err := grpc.NewClient(cfg.OTLPEndpoint, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, fmt.Errorf("opik: dialing OTLP collector: %w", err)
+	}
+
+	return &otlpGRPCTransport{
+		conn:   conn,
+		client: coltracepb.NewTraceServiceClient(conn),
+	}, nil
+}
+
+func (t *otlpGRPCTransport) SubmitTrace(ctx context.Context, tr *traceData) error {
+	span, err := traceDataToSpanProto(tr)
+	if err != nil {
+		return err
+	}
+	return t.export(ctx, span)
+}
+
+func (t *otlpGRPCTransport) SubmitSpan(ctx context.Context, s *spanData) error {
+	span, err := spanDataToSpanProto(s)
+	if err != nil {
+		return err
+	}
+	return t.export(ctx, span)
+}
+
+func (t *otlpGRPCTransport) SubmitFeedback(ctx context.Context, traceID, spanID string, score FeedbackScore) error {
+	otelTraceID, err := encodeOTLPTraceID(traceID)
+	if err != nil {
+		return err
+	}
+	otelSpanID, err := encodeOTLPSpanID(spanID)
+	if err != nil {
+		return err
+	}
+
+	event := &tracepb.Span_Event{
+		Name: "feedback_score",
+		Attributes: []*commonpb.KeyValue{
+			stringAttr("name", score.Name),
+			{Key: "score", Value: &commonpb.AnyValue{Value: &commonpb.AnyValue_DoubleValue{DoubleValue: score.Value}}},
+			stringAttr("reason", score.Reason),
+		},
+	}
+	span := &tracepb.Span{
+		TraceId: otelTraceID,
+		SpanId:  otelSpanID,
+		Events:  []*tracepb.Span_Event{event},
+	}
+	return t.export(ctx, span)
+}
+
+func (t *otlpGRPCTransport) export(ctx context.Context, span *tracepb.Span) error {
+	req := &coltracepb.ExportTraceServiceRequest{
+		ResourceSpans: []*tracepb.ResourceSpans{
+			{
+				ScopeSpans: []*tracepb.ScopeSpans{
+					{Spans: []*tracepb.Span{span}},
+				},
+			},
+		},
+	}
+	_, err := t.client.Export(ctx, req)
+	return err
+}
+
+func (t *otlpGRPCTransport) Close() error {
+	return t.conn.Close()
+}
+
+func stringAttr(key, value string) *commonpb.KeyValue {
+	return &commonpb.KeyValue{
+		Key:   key,
+		Value: &commonpb.AnyValue{Value: &commonpb.AnyValue_StringValue{StringValue: value}},
+	}
+}
+
+// encodeOTLPTraceID converts an Opik trace ID (a UUID string) into the
+// 16-byte identifier OTLP requires. Opik IDs are already 16 raw bytes once
+// parsed as a UUID, so this is a straight reinterpretation, not a hash.
+func encodeOTLPTraceID(id string) ([]byte, error) {
+	u, err := uuid.Parse(id)
+	if err != nil {
+		return nil, fmt.Errorf("opik: %q is not a valid UUID, can't encode as an OTLP trace ID: %w", id, err)
+	}
+	b := u // [16]byte
+	return b[:], nil
+}
+
+// encodeOTLPSpanID converts an Opik span ID (a UUID string) into the 8-byte
+// identifier OTLP requires by taking the low 8 bytes of the UUID. This is
+// lossy but matches common OTel-bridge practice, since Opik span IDs are
+// full UUIDs and OTLP span IDs are half that width.
+func encodeOTLPSpanID(id string) ([]byte, error) {
+	u, err := uuid.Parse(id)
+	if err != nil {
+		return nil, fmt.Errorf("opik: %q is not a valid UUID, can't encode as an OTLP span ID: %w", id, err)
+	}
+	return u[8:], nil
+}
+
+// traceDataToSpanProto and spanDataToSpanProto encode Opik's internal trace
+// and span payloads as OTLP Span protos so they can be exported over the
+// same path. A trace is represented as a span whose SpanId is derived from
+// its own TraceId, giving it a stable root span to attach child spans to.
+func traceDataToSpanProto(tr *traceData) (*tracepb.Span, error) {
+	traceID, err := encodeOTLPTraceID(tr.id)
+	if err != nil {
+		return nil, err
+	}
+	spanID, err := encodeOTLPSpanID(tr.id)
+	if err != nil {
+		return nil, err
+	}
+	return &tracepb.Span{
+		TraceId: traceID,
+		SpanId:  spanID,
+		Name:    tr.name,
+	}, nil
+}
+
+func spanDataToSpanProto(s *spanData) (*tracepb.Span, error) {
+	traceID, err := encodeOTLPTraceID(s.traceID)
+	if err != nil {
+		return nil, err
+	}
+	spanID, err := encodeOTLPSpanID(s.id)
+	if err != nil {
+		return nil, err
+	}
+	parentSpanID, err := encodeOTLPSpanID(s.traceID)
+	if err != nil {
+		return nil, err
+	}
+	return &tracepb.Span{
+		TraceId:      traceID,
+		SpanId:       spanID,
+		ParentSpanId: parentSpanID,
+		Name:         s.name,
+	}, nil
+}
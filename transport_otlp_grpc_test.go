@@ -0,0 +1,106 @@
+package opik
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	coltracepb "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	tracepb "go.opentelemetry.io/proto/otlp/trace/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// mockTraceServiceServer records every ExportTraceServiceRequest it receives
+// so tests can assert on the spans that were encoded and sent.
+type mockTraceServiceServer struct {
+	coltracepb.UnimplementedTraceServiceServer
+	received []*tracepb.Span
+}
+
+func (m *mockTraceServiceServer) Export(ctx context.Context, req *coltracepb.ExportTraceServiceRequest) (*coltracepb.ExportTraceServiceResponse, error) {
+	for _, rs := range req.GetResourceSpans() {
+		for _, ss := range rs.GetScopeSpans() {
+			m.received = append(m.received, ss.GetSpans()...)
+		}
+	}
+	return &coltracepb.ExportTraceServiceResponse{}, nil
+}
+
+func TestIntegration_OTLPGRPCTransport(t *testing.T) {
+	lis := bufconn.Listen(1024 * 1024)
+	srv := grpc.NewServer()
+	mock := &mockTraceServiceServer{}
+	coltracepb.RegisterTraceServiceServer(srv, mock)
+	go srv.Serve(lis)
+	defer srv.Stop()
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatalf("Failed to dial bufconn: %v", err)
+	}
+	defer conn.Close()
+
+	transport := &otlpGRPCTransport{
+		conn:   conn,
+		client: coltracepb.NewTraceServiceClient(conn),
+	}
+
+	ctx := context.Background()
+	span := &spanData{id: newID(), traceID: newID(), name: "otlp-test-span"}
+	if err := transport.SubmitSpan(ctx, span); err != nil {
+		t.Fatalf("Failed to submit span: %v", err)
+	}
+
+	if len(mock.received) != 1 {
+		t.Fatalf("expected mock collector to receive 1 span, got %d", len(mock.received))
+	}
+	if mock.received[0].Name != "otlp-test-span" {
+		t.Fatalf("expected span name %q, got %q", "otlp-test-span", mock.received[0].Name)
+	}
+}
+
+// TestIntegration_WithTransportOTLPGRPC exercises the public
+// NewClient/WithTransport(TransportOTLPGRPC)/WithOTLPEndpoint path, rather
+// than constructing an otlpGRPCTransport directly, so a regression that
+// leaves that path unusable (e.g. no way to set Config.OTLPEndpoint) is
+// actually caught.
+func TestIntegration_WithTransportOTLPGRPC(t *testing.T) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Failed to listen: %v", err)
+	}
+	srv := grpc.NewServer()
+	mock := &mockTraceServiceServer{}
+	coltracepb.RegisterTraceServiceServer(srv, mock)
+	go srv.Serve(lis)
+	defer srv.Stop()
+
+	client, err := NewClient(
+		WithProjectName("go-sdk-integration-tests"),
+		WithTransport(TransportOTLPGRPC),
+		WithOTLPEndpoint(lis.Addr().String()),
+	)
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	ctx := context.Background()
+	tr, err := client.Trace(ctx, "otlp-client-trace")
+	if err != nil {
+		t.Fatalf("Failed to create trace: %v", err)
+	}
+	if err := tr.End(ctx); err != nil {
+		t.Fatalf("Failed to end trace: %v", err)
+	}
+
+	if len(mock.received) == 0 {
+		t.Fatal("expected mock collector to receive at least one span from the trace")
+	}
+}
@@ -0,0 +1,53 @@
+package opik
+
+import "context"
+
+// restTransport is the default Transport, issuing one HTTP request per
+// trace, span, or feedback score against the Opik REST API through its
+// Transport methods; its SubmitTracesBulk/SubmitSpansBulk methods post many
+// items in one request. It wraps the
+// Client's existing HTTP plumbing so behavior is unchanged from before the
+// Transport abstraction was introduced.
+type restTransport struct {
+	config Config
+}
+
+func newRESTTransport(cfg Config) Transport {
+	return &restTransport{config: cfg}
+}
+
+func (t *restTransport) SubmitTrace(ctx context.Context, tr *traceData) error {
+	return httpUpsertTrace(ctx, t.config, tr)
+}
+
+func (t *restTransport) SubmitSpan(ctx context.Context, s *spanData) error {
+	return httpUpsertSpan(ctx, t.config, s)
+}
+
+func (t *restTransport) SubmitFeedback(ctx context.Context, traceID, spanID string, score FeedbackScore) error {
+	return httpSubmitFeedback(ctx, t.config, spanID, score)
+}
+
+func (t *restTransport) Close() error {
+	return nil
+}
+
+// SubmitTracesBulk posts traces to /v1/private/traces/batch in one request,
+// used by the batch exporter instead of one SubmitTrace call per item.
+func (t *restTransport) SubmitTracesBulk(ctx context.Context, traces []*traceData) error {
+	payloads := make([]map[string]any, len(traces))
+	for i, tr := range traces {
+		payloads[i] = traceWirePayload(tr)
+	}
+	return httpPostBulk(ctx, t.config, "/v1/private/traces/batch", payloads)
+}
+
+// SubmitSpansBulk posts spans to /v1/private/spans/batch in one request,
+// used by the batch exporter instead of one SubmitSpan call per item.
+func (t *restTransport) SubmitSpansBulk(ctx context.Context, spans []*spanData) error {
+	payloads := make([]map[string]any, len(spans))
+	for i, s := range spans {
+		payloads[i] = spanWirePayload(s)
+	}
+	return httpPostBulk(ctx, t.config, "/v1/private/spans/batch", payloads)
+}